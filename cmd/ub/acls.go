@@ -0,0 +1,264 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+func newACLsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "acls",
+		Short: "Create, list, and delete Kafka ACLs",
+	}
+
+	cmd.AddCommand(newACLsCreateCmd())
+	cmd.AddCommand(newACLsListCmd())
+	cmd.AddCommand(newACLsDeleteCmd())
+
+	return cmd
+}
+
+// aclFilterFlags are the flags shared by `acls list` and `acls delete`, both of
+// which describe the bindings they act on as an ACLBindingFilter.
+type aclFilterFlags struct {
+	resourceType        string
+	resourceName        string
+	resourcePatternType string
+	principal           string
+	host                string
+	operation           string
+	permissionType      string
+}
+
+func addACLBindingFlags(flags *pflag.FlagSet, f *aclFilterFlags, action, permissionTypeDefault string) {
+	flags.StringVar(&f.resourceType, "resource-type", "any", "resource type: any, topic, group, broker")
+	flags.StringVar(&f.resourceName, "resource-name", "", "resource name")
+	flags.StringVar(&f.resourcePatternType, "pattern-type", "literal", "resource pattern type: any, match, literal, prefixed")
+	flags.StringVar(&f.principal, "principal", "", fmt.Sprintf("principal to %s, e.g. User:alice", action))
+	flags.StringVar(&f.host, "host", "*", "host the principal connects from")
+	flags.StringVar(&f.operation, "operation", "any", "ACL operation: read, write, create, delete, alter, describe, ...")
+	flags.StringVar(&f.permissionType, "permission-type", permissionTypeDefault, "permission type: allow or deny")
+}
+
+// formatACLBinding renders an ACLBinding the way `kafka-acls.sh` does: a short
+// principal/host/operation/permission summary scoped to its resource.
+func formatACLBinding(b kafka.ACLBinding) string {
+	return fmt.Sprintf("%s:%s (pattern=%s) principal=%s host=%s operation=%s permission=%s",
+		b.Type, b.Name, b.ResourcePatternType, b.Principal, b.Host, b.Operation, b.PermissionType)
+}
+
+func (f aclFilterFlags) toBinding() (kafka.ACLBinding, error) {
+	resourceType, err := kafka.ResourceTypeFromString(f.resourceType)
+	if err != nil {
+		return kafka.ACLBinding{}, fmt.Errorf("invalid --resource-type %q: %w", f.resourceType, err)
+	}
+
+	patternType, err := kafka.ResourcePatternTypeFromString(f.resourcePatternType)
+	if err != nil {
+		return kafka.ACLBinding{}, fmt.Errorf("invalid --pattern-type %q: %w", f.resourcePatternType, err)
+	}
+
+	operation, err := kafka.ACLOperationFromString(f.operation)
+	if err != nil {
+		return kafka.ACLBinding{}, fmt.Errorf("invalid --operation %q: %w", f.operation, err)
+	}
+
+	permissionType, err := kafka.ACLPermissionTypeFromString(f.permissionType)
+	if err != nil {
+		return kafka.ACLBinding{}, fmt.Errorf("invalid --permission-type %q: %w", f.permissionType, err)
+	}
+
+	return kafka.ACLBinding{
+		Type:                resourceType,
+		Name:                f.resourceName,
+		ResourcePatternType: patternType,
+		Principal:           f.principal,
+		Host:                f.host,
+		Operation:           operation,
+		PermissionType:      permissionType,
+	}, nil
+}
+
+func newACLsCreateCmd() *cobra.Command {
+	f := aclFilterFlags{}
+	var (
+		timeoutSecs int
+		output      string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an ACL",
+	}
+	connOpts := addConnectionFlags(cmd.Flags())
+	addACLBindingFlags(cmd.Flags(), &f, "grant access to", "allow")
+	cmd.Flags().IntVar(&timeoutSecs, "timeout", 30, "number of seconds to wait for the operation to complete")
+	cmd.Flags().StringVar(&output, "output", "table", "output format: json or table")
+	cmd.MarkFlagRequired("resource-name")
+	cmd.MarkFlagRequired("principal")
+	// --resource-type and --operation default to the "any" filter value, which
+	// the broker rejects for CreateACLs; a real type/operation must be given.
+	cmd.MarkFlagRequired("resource-type")
+	cmd.MarkFlagRequired("operation")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		format, err := parseOutputFormat(output)
+		if err != nil {
+			return err
+		}
+
+		binding, err := f.toBinding()
+		if err != nil {
+			return err
+		}
+
+		admin, closeAdmin, err := newConnectedAdminClient(connOpts.Kafka, connOpts.SSH)
+		if err != nil {
+			return fmt.Errorf("failed to create admin client: %w", err)
+		}
+		defer closeAdmin()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSecs)*time.Second)
+		defer cancel()
+
+		results, err := admin.CreateACLs(ctx, kafka.ACLBindings{binding})
+		if err != nil {
+			return fmt.Errorf("failed to create ACL: %w", err)
+		}
+
+		rows := make([][]string, 0, len(results))
+		for _, r := range results {
+			status := "OK"
+			if r.Error.Code() != kafka.ErrNoError {
+				status = r.Error.String()
+			}
+			rows = append(rows, []string{formatACLBinding(binding), status})
+		}
+
+		return writeResult(os.Stdout, format, []string{"ACL", "STATUS"}, rows, results)
+	}
+
+	return cmd
+}
+
+func newACLsListCmd() *cobra.Command {
+	f := aclFilterFlags{}
+	var (
+		timeoutSecs int
+		output      string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List ACLs matching a filter",
+	}
+	connOpts := addConnectionFlags(cmd.Flags())
+	addACLBindingFlags(cmd.Flags(), &f, "list", "any")
+	cmd.Flags().IntVar(&timeoutSecs, "timeout", 30, "number of seconds to wait for the operation to complete")
+	cmd.Flags().StringVar(&output, "output", "table", "output format: json or table")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		format, err := parseOutputFormat(output)
+		if err != nil {
+			return err
+		}
+
+		filter, err := f.toBinding()
+		if err != nil {
+			return err
+		}
+
+		admin, closeAdmin, err := newConnectedAdminClient(connOpts.Kafka, connOpts.SSH)
+		if err != nil {
+			return fmt.Errorf("failed to create admin client: %w", err)
+		}
+		defer closeAdmin()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSecs)*time.Second)
+		defer cancel()
+
+		result, err := admin.DescribeACLs(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("failed to list ACLs: %w", err)
+		}
+		if result.Error.Code() != kafka.ErrNoError {
+			return fmt.Errorf("failed to list ACLs: %s", result.Error.String())
+		}
+
+		rows := make([][]string, 0, len(result.ACLBindings))
+		for _, b := range result.ACLBindings {
+			rows = append(rows, []string{b.Type.String(), b.Name, b.ResourcePatternType.String(), b.Principal, b.Host, b.Operation.String(), b.PermissionType.String()})
+		}
+
+		return writeResult(os.Stdout, format, []string{"TYPE", "NAME", "PATTERN", "PRINCIPAL", "HOST", "OPERATION", "PERMISSION"}, rows, result.ACLBindings)
+	}
+
+	return cmd
+}
+
+func newACLsDeleteCmd() *cobra.Command {
+	f := aclFilterFlags{}
+	var (
+		timeoutSecs int
+		output      string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete ACLs matching a filter",
+	}
+	connOpts := addConnectionFlags(cmd.Flags())
+	addACLBindingFlags(cmd.Flags(), &f, "delete", "any")
+	cmd.Flags().IntVar(&timeoutSecs, "timeout", 30, "number of seconds to wait for the operation to complete")
+	cmd.Flags().StringVar(&output, "output", "table", "output format: json or table")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		format, err := parseOutputFormat(output)
+		if err != nil {
+			return err
+		}
+
+		filter, err := f.toBinding()
+		if err != nil {
+			return err
+		}
+
+		admin, closeAdmin, err := newConnectedAdminClient(connOpts.Kafka, connOpts.SSH)
+		if err != nil {
+			return fmt.Errorf("failed to create admin client: %w", err)
+		}
+		defer closeAdmin()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSecs)*time.Second)
+		defer cancel()
+
+		results, err := admin.DeleteACLs(ctx, kafka.ACLBindingFilters{filter})
+		if err != nil {
+			return fmt.Errorf("failed to delete ACLs: %w", err)
+		}
+
+		rows := make([][]string, 0)
+		for _, r := range results {
+			status := "OK"
+			if r.Error.Code() != kafka.ErrNoError {
+				status = r.Error.String()
+			}
+			for _, b := range r.ACLBindings {
+				rows = append(rows, []string{formatACLBinding(b), status})
+			}
+			if len(r.ACLBindings) == 0 {
+				rows = append(rows, []string{"(no matching ACLs)", status})
+			}
+		}
+
+		return writeResult(os.Stdout, format, []string{"ACL", "STATUS"}, rows, results)
+	}
+
+	return cmd
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+func TestACLFilterFlagsToBinding(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   aclFilterFlags
+		want    kafka.ACLBinding
+		wantErr bool
+	}{
+		{
+			name: "topic allow read",
+			flags: aclFilterFlags{
+				resourceType:        "topic",
+				resourceName:        "orders",
+				resourcePatternType: "literal",
+				principal:           "User:alice",
+				host:                "*",
+				operation:           "read",
+				permissionType:      "allow",
+			},
+			want: kafka.ACLBinding{
+				Type:                kafka.ResourceTopic,
+				Name:                "orders",
+				ResourcePatternType: kafka.ResourcePatternTypeLiteral,
+				Principal:           "User:alice",
+				Host:                "*",
+				Operation:           kafka.ACLOperationRead,
+				PermissionType:      kafka.ACLPermissionTypeAllow,
+			},
+		},
+		{
+			name: "invalid resource type",
+			flags: aclFilterFlags{
+				resourceType:        "nonsense",
+				resourcePatternType: "literal",
+				operation:           "any",
+				permissionType:      "any",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid operation",
+			flags: aclFilterFlags{
+				resourceType:        "any",
+				resourcePatternType: "literal",
+				operation:           "nonsense",
+				permissionType:      "any",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.flags.toBinding()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("toBinding() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("toBinding() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
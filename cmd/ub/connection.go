@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/spf13/pflag"
+)
+
+// ConnectionOptions bundles everything needed to reach a Kafka cluster: the
+// client config itself, plus an optional SSH bastion to tunnel through.
+type ConnectionOptions struct {
+	Kafka KafkaConfigOptions
+	SSH   SSHTunnelOptions
+}
+
+// addConnectionFlags registers the flags shared by every command that
+// connects to a Kafka cluster (client config and, optionally, an SSH tunnel)
+// and returns the options they populate.
+func addConnectionFlags(flags *pflag.FlagSet) *ConnectionOptions {
+	opts := &ConnectionOptions{}
+
+	flags.StringVar(&opts.Kafka.BootstrapServers, "bootstrap-servers", "", "comma-separated list of host:port pairs")
+	flags.StringVar(&opts.Kafka.ConfigFile, "config-file", "", "path to a Kafka client properties file")
+	flags.StringVar(&opts.Kafka.SecurityProtocol, "security-protocol", "", "security protocol, e.g. PLAINTEXT, SSL, SASL_SSL")
+
+	flags.StringVar(&opts.Kafka.SSLCALocation, "ssl-ca-location", "", "path to the CA certificate file used to verify the broker's certificate")
+	flags.StringVar(&opts.Kafka.SSLCertificateLocation, "ssl-certificate-location", "", "path to the client's public key (PEM) used for mTLS")
+	flags.StringVar(&opts.Kafka.SSLKeyLocation, "ssl-key-location", "", "path to the client's private key (PEM) used for mTLS")
+	flags.StringVar(&opts.Kafka.SSLKeyPassword, "ssl-key-password", "", "password for the private key given in --ssl-key-location")
+
+	flags.StringVar(&opts.Kafka.SASLMechanism, "sasl-mechanism", "", "SASL mechanism, e.g. PLAIN, SCRAM-SHA-512")
+	flags.StringVar(&opts.Kafka.SASLUsername, "sasl-username", "", "SASL username")
+	flags.StringVar(&opts.Kafka.SASLPassword, "sasl-password", "", "SASL password")
+
+	opts.SSH = *addSSHTunnelFlags(flags)
+
+	return opts
+}
+
+// resolveKafkaConfig builds a kafka.ConfigMap from kafkaOpts and, if an SSH
+// bastion was configured, rewrites bootstrap.servers to route through local
+// tunnels. The returned closer must be called once the caller is done with
+// the connection to tear down any tunnels.
+func resolveKafkaConfig(kafkaOpts KafkaConfigOptions, sshOpts SSHTunnelOptions) (*kafka.ConfigMap, func(), error) {
+	config, err := buildKafkaConfig(kafkaOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !sshOpts.enabled() {
+		return config, func() {}, nil
+	}
+
+	dialer, err := applySSHTunnel(config, &sshOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return config, dialer.Close, nil
+}
+
+// newConnectedAdminClient resolves kafkaOpts/sshOpts into a ready-to-use
+// AdminClient, tunneling through SSH first if configured. The returned closer
+// closes both the AdminClient and any SSH tunnels.
+func newConnectedAdminClient(kafkaOpts KafkaConfigOptions, sshOpts SSHTunnelOptions) (*kafka.AdminClient, func(), error) {
+	config, closeTunnel, err := resolveKafkaConfig(kafkaOpts, sshOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	admin, err := kafka.NewAdminClient(config)
+	if err != nil {
+		closeTunnel()
+		return nil, nil, fmt.Errorf("failed to create admin client: %w", err)
+	}
+
+	return admin, func() {
+		admin.Close()
+		closeTunnel()
+	}, nil
+}
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/spf13/pflag"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// SSHTunnelOptions holds the parameters needed to reach a Kafka cluster through
+// an SSH bastion, for environments where the container can't route directly to
+// the brokers' advertised addresses.
+type SSHTunnelOptions struct {
+	Host           string
+	User           string
+	PrivateKey     string
+	Passphrase     string
+	KnownHostsFile string
+	Jump           bool
+}
+
+// enabled reports whether any SSH tunnel flags were set.
+func (o SSHTunnelOptions) enabled() bool {
+	return o.Host != ""
+}
+
+// addSSHTunnelFlags registers the --ssh-* flags shared by every command that
+// connects to a Kafka cluster and returns the options they populate.
+func addSSHTunnelFlags(flags *pflag.FlagSet) *SSHTunnelOptions {
+	opts := &SSHTunnelOptions{}
+
+	flags.StringVar(&opts.Host, "ssh-host", "", "SSH bastion host (host:port) to tunnel the Kafka connection through")
+	flags.StringVar(&opts.User, "ssh-user", "", "SSH username")
+	flags.StringVar(&opts.PrivateKey, "ssh-private-key", "", "path to the SSH private key used to authenticate to --ssh-host")
+	flags.StringVar(&opts.Passphrase, "ssh-passphrase", "", "passphrase for --ssh-private-key, if encrypted")
+	flags.StringVar(&opts.KnownHostsFile, "ssh-known-hosts", "", "path to a known_hosts file used to verify --ssh-host's key")
+	flags.BoolVar(&opts.Jump, "ssh-jump", false, "after connecting, resolve the full broker list from cluster metadata and tunnel every broker through the same bastion")
+
+	return opts
+}
+
+// sshTunnel forwards a local TCP listener to a single remote address over an
+// SSH connection.
+type sshTunnel struct {
+	listener   net.Listener
+	remoteAddr string
+}
+
+// sshDialer owns the SSH connection and every local tunnel opened through it.
+type sshDialer struct {
+	client  *ssh.Client
+	tunnels []*sshTunnel
+}
+
+// newSSHDialer authenticates to opts.Host using the given private key and
+// returns a dialer ready to open per-broker tunnels.
+func newSSHDialer(opts *SSHTunnelOptions) (*sshDialer, error) {
+	keyBytes, err := os.ReadFile(opts.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read --ssh-private-key %q: %w", opts.PrivateKey, err)
+	}
+
+	var signer ssh.Signer
+	if opts.Passphrase != "" {
+		signer, err = ssh.ParsePrivateKeyWithPassphrase(keyBytes, []byte(opts.Passphrase))
+	} else {
+		signer, err = ssh.ParsePrivateKey(keyBytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse --ssh-private-key %q: %w", opts.PrivateKey, err)
+	}
+
+	hostKeyCallback, err := sshHostKeyCallback(opts.KnownHostsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	host := opts.Host
+	if !strings.Contains(host, ":") {
+		host = net.JoinHostPort(host, "22")
+	}
+
+	client, err := ssh.Dial("tcp", host, &ssh.ClientConfig{
+		User:            opts.User,
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         10 * time.Second,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to establish SSH connection to %q: %w", host, err)
+	}
+
+	return &sshDialer{client: client}, nil
+}
+
+func sshHostKeyCallback(knownHostsFile string) (ssh.HostKeyCallback, error) {
+	if knownHostsFile == "" {
+		fmt.Fprintln(os.Stderr, "Warning: --ssh-known-hosts not set, host key verification is disabled")
+		return ssh.InsecureIgnoreHostKey(), nil
+	}
+
+	callback, err := knownhosts.New(knownHostsFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read --ssh-known-hosts %q: %w", knownHostsFile, err)
+	}
+	return callback, nil
+}
+
+// tunnel opens a local listener that forwards every connection to remoteAddr
+// over the SSH connection, and returns the local address to dial instead.
+func (d *sshDialer) tunnel(remoteAddr string) (string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", fmt.Errorf("failed to open local listener for %q: %w", remoteAddr, err)
+	}
+
+	d.tunnels = append(d.tunnels, &sshTunnel{listener: listener, remoteAddr: remoteAddr})
+
+	go d.acceptLoop(listener, remoteAddr)
+
+	return listener.Addr().String(), nil
+}
+
+func (d *sshDialer) acceptLoop(listener net.Listener, remoteAddr string) {
+	for {
+		local, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go d.forward(local, remoteAddr)
+	}
+}
+
+func (d *sshDialer) forward(local net.Conn, remoteAddr string) {
+	defer local.Close()
+
+	remote, err := d.client.Dial("tcp", remoteAddr)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error dialing %q through SSH tunnel: %v\n", remoteAddr, err)
+		return
+	}
+	defer remote.Close()
+
+	done := make(chan struct{}, 2)
+	go func() { io.Copy(remote, local); done <- struct{}{} }()
+	go func() { io.Copy(local, remote); done <- struct{}{} }()
+	<-done
+}
+
+// Close tears down every open tunnel listener and the underlying SSH connection.
+func (d *sshDialer) Close() {
+	for _, t := range d.tunnels {
+		t.listener.Close()
+	}
+	d.client.Close()
+}
+
+// applySSHTunnel rewrites config's bootstrap.servers to route through local
+// tunnels opened over SSH. When opts.Jump is set, it first tunnels just the
+// configured brokers, queries cluster metadata through them, and then tunnels
+// every broker discovered so the whole cluster becomes reachable through the
+// one bastion.
+func applySSHTunnel(config *kafka.ConfigMap, opts *SSHTunnelOptions) (*sshDialer, error) {
+	dialer, err := newSSHDialer(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	bootstrapVal, err := config.Get("bootstrap.servers", "")
+	if err != nil || bootstrapVal == "" {
+		dialer.Close()
+		return nil, fmt.Errorf("bootstrap.servers must be set before an SSH tunnel can be established")
+	}
+	brokers := strings.Split(bootstrapVal.(string), ",")
+
+	rewritten, err := dialer.tunnelBrokers(brokers)
+	if err != nil {
+		dialer.Close()
+		return nil, err
+	}
+	if err := config.SetKey("bootstrap.servers", rewritten); err != nil {
+		dialer.Close()
+		return nil, fmt.Errorf("failed to set bootstrap.servers: %w", err)
+	}
+
+	if opts.Jump {
+		allBrokers, err := discoverBrokers(config)
+		if err != nil {
+			dialer.Close()
+			return nil, fmt.Errorf("failed to resolve broker list for --ssh-jump: %w", err)
+		}
+
+		rewritten, err := dialer.tunnelBrokers(allBrokers)
+		if err != nil {
+			dialer.Close()
+			return nil, err
+		}
+		if err := config.SetKey("bootstrap.servers", rewritten); err != nil {
+			dialer.Close()
+			return nil, fmt.Errorf("failed to set bootstrap.servers: %w", err)
+		}
+	}
+
+	return dialer, nil
+}
+
+// tunnelBrokers opens one tunnel per broker address and returns the
+// comma-separated list of local listener addresses to use as bootstrap.servers.
+func (d *sshDialer) tunnelBrokers(brokers []string) (string, error) {
+	local := make([]string, 0, len(brokers))
+	for _, broker := range brokers {
+		broker = strings.TrimSpace(broker)
+		if broker == "" {
+			continue
+		}
+		addr, err := d.tunnel(broker)
+		if err != nil {
+			return "", err
+		}
+		local = append(local, addr)
+	}
+	return strings.Join(local, ","), nil
+}
+
+// discoverBrokers connects through the tunnels already in place to fetch
+// cluster metadata and returns every broker's advertised host:port.
+func discoverBrokers(config *kafka.ConfigMap) ([]string, error) {
+	admin, err := kafka.NewAdminClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create admin client: %w", err)
+	}
+	defer admin.Close()
+
+	metadata, err := admin.GetMetadata(nil, true, defaultMetadataTimeoutMs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch cluster metadata: %w", err)
+	}
+
+	brokers := make([]string, 0, len(metadata.Brokers))
+	for _, b := range metadata.Brokers {
+		brokers = append(brokers, fmt.Sprintf("%s:%d", b.Host, b.Port))
+	}
+	return brokers, nil
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"io"
+	"net"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// directTCPIPPayload mirrors the "direct-tcpip" channel-open payload defined
+// by RFC 4254 section 7.2, which golang.org/x/crypto/ssh doesn't export.
+type directTCPIPPayload struct {
+	DestAddr   string
+	DestPort   uint32
+	OriginAddr string
+	OriginPort uint32
+}
+
+// startTestSSHServer starts an in-process SSH server that services
+// "direct-tcpip" (port-forward) requests by dialing targetAddr, standing in
+// for a real sshd so tunnel forwarding can be tested without one. It returns
+// an *ssh.Client already connected to the server; the caller is responsible
+// for closing it.
+func startTestSSHServer(t *testing.T, targetAddr string) *ssh.Client {
+	t.Helper()
+
+	_, hostPriv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate host key: %v", err)
+	}
+	hostSigner, err := ssh.NewSignerFromSigner(hostPriv)
+	if err != nil {
+		t.Fatalf("failed to build host signer: %v", err)
+	}
+
+	config := &ssh.ServerConfig{NoClientAuth: true}
+	config.AddHostKey(hostSigner)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen for test SSH server: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		serverConn, chans, reqs, err := ssh.NewServerConn(conn, config)
+		if err != nil {
+			return
+		}
+		defer serverConn.Close()
+		go ssh.DiscardRequests(reqs)
+
+		for newChannel := range chans {
+			if newChannel.ChannelType() != "direct-tcpip" {
+				newChannel.Reject(ssh.UnknownChannelType, "unsupported channel type")
+				continue
+			}
+
+			var payload directTCPIPPayload
+			if err := ssh.Unmarshal(newChannel.ExtraData(), &payload); err != nil {
+				newChannel.Reject(ssh.ConnectionFailed, "malformed forward request")
+				continue
+			}
+
+			target, err := net.Dial("tcp", targetAddr)
+			if err != nil {
+				newChannel.Reject(ssh.ConnectionFailed, err.Error())
+				continue
+			}
+
+			channel, requests, err := newChannel.Accept()
+			if err != nil {
+				target.Close()
+				continue
+			}
+			go ssh.DiscardRequests(requests)
+
+			go func() {
+				defer channel.Close()
+				defer target.Close()
+				done := make(chan struct{}, 2)
+				go func() { io.Copy(target, channel); done <- struct{}{} }()
+				go func() { io.Copy(channel, target); done <- struct{}{} }()
+				<-done
+			}()
+		}
+	}()
+
+	clientConn, err := ssh.Dial("tcp", listener.Addr().String(), &ssh.ClientConfig{
+		User:            "test",
+		Auth:            []ssh.AuthMethod{ssh.Password("unused")},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	})
+	if err != nil {
+		t.Fatalf("failed to dial test SSH server: %v", err)
+	}
+	return clientConn
+}
+
+func TestTunnelBrokers_RoundTripsBytes(t *testing.T) {
+	echoListener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start echo listener: %v", err)
+	}
+	defer echoListener.Close()
+
+	go func() {
+		for {
+			conn, err := echoListener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	sshClient := startTestSSHServer(t, echoListener.Addr().String())
+	dialer := &sshDialer{client: sshClient}
+	defer dialer.Close()
+
+	local, err := dialer.tunnelBrokers([]string{echoListener.Addr().String()})
+	if err != nil {
+		t.Fatalf("tunnelBrokers() error = %v", err)
+	}
+
+	conn, err := net.Dial("tcp", local)
+	if err != nil {
+		t.Fatalf("failed to dial tunnelled address %q: %v", local, err)
+	}
+	defer conn.Close()
+
+	want := []byte("hello through the tunnel")
+	if _, err := conn.Write(want); err != nil {
+		t.Fatalf("failed to write to tunnel: %v", err)
+	}
+
+	got := make([]byte, len(want))
+	if _, err := io.ReadFull(conn, got); err != nil {
+		t.Fatalf("failed to read echoed bytes back: %v", err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("round-tripped bytes = %q, want %q", got, want)
+	}
+}
+
+func TestSSHHostKeyCallback_InsecureFallback(t *testing.T) {
+	callback, err := sshHostKeyCallback("")
+	if err != nil {
+		t.Fatalf("sshHostKeyCallback(\"\") error = %v, want nil", err)
+	}
+	if callback == nil {
+		t.Error("sshHostKeyCallback(\"\") returned a nil callback")
+	}
+}
+
+func TestSSHHostKeyCallback_MissingKnownHostsFile(t *testing.T) {
+	_, err := sshHostKeyCallback("/nonexistent/known_hosts")
+	if err == nil {
+		t.Error("sshHostKeyCallback() expected error for missing known_hosts file, got nil")
+	}
+}
+
+func TestSSHTunnelOptions_Enabled(t *testing.T) {
+	tests := []struct {
+		name string
+		opts SSHTunnelOptions
+		want bool
+	}{
+		{name: "no host set", opts: SSHTunnelOptions{}, want: false},
+		{name: "host set", opts: SSHTunnelOptions{Host: "bastion:22"}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.opts.enabled(); got != tt.want {
+				t.Errorf("enabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
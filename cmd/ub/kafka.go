@@ -2,12 +2,16 @@ package main
 
 import (
 	"bufio"
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/confluentinc/cp-docker-utils/internal/readiness"
 )
 
 const (
@@ -57,13 +61,39 @@ func parsePropertiesFile(path string) (map[string]string, error) {
 	return properties, nil
 }
 
-// buildKafkaConfig creates a kafka.ConfigMap from the provided parameters.
+// KafkaConfigOptions holds the parameters used to build a kafka.ConfigMap.
+// Flags take precedence over values loaded from ConfigFile.
+type KafkaConfigOptions struct {
+	BootstrapServers string
+	ConfigFile       string
+	SecurityProtocol string
+
+	SSLCALocation          string
+	SSLCertificateLocation string
+	SSLKeyLocation         string
+	SSLKeyPassword         string
+
+	SASLMechanism string
+	SASLUsername  string
+	SASLPassword  string
+}
+
+// validateReadableFile checks that path exists and can be opened for reading.
+func validateReadableFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// buildKafkaConfig creates a kafka.ConfigMap from the provided options.
 // Priority: explicit flags > config file values
-func buildKafkaConfig(bootstrapServers, configFile, securityProtocol string) (*kafka.ConfigMap, error) {
+func buildKafkaConfig(opts KafkaConfigOptions) (*kafka.ConfigMap, error) {
 	config := &kafka.ConfigMap{}
 
-	if configFile != "" {
-		props, err := parsePropertiesFile(configFile)
+	if opts.ConfigFile != "" {
+		props, err := parsePropertiesFile(opts.ConfigFile)
 		if err != nil {
 			return nil, err
 		}
@@ -75,18 +105,46 @@ func buildKafkaConfig(bootstrapServers, configFile, securityProtocol string) (*k
 		}
 	}
 
-	if bootstrapServers != "" {
-		if err := config.SetKey("bootstrap.servers", bootstrapServers); err != nil {
+	if opts.BootstrapServers != "" {
+		if err := config.SetKey("bootstrap.servers", opts.BootstrapServers); err != nil {
 			return nil, fmt.Errorf("failed to set bootstrap.servers: %w", err)
 		}
 	}
 
-	if securityProtocol != "" {
-		if err := config.SetKey("security.protocol", securityProtocol); err != nil {
+	if opts.SecurityProtocol != "" {
+		if err := config.SetKey("security.protocol", opts.SecurityProtocol); err != nil {
 			return nil, fmt.Errorf("failed to set security.protocol: %w", err)
 		}
 	}
 
+	if err := applyPEMFiles(config, opts); err != nil {
+		return nil, err
+	}
+
+	if opts.SSLKeyPassword != "" {
+		if err := config.SetKey("ssl.key.password", opts.SSLKeyPassword); err != nil {
+			return nil, fmt.Errorf("failed to set ssl.key.password: %w", err)
+		}
+	}
+
+	if opts.SASLMechanism != "" {
+		if err := config.SetKey("sasl.mechanism", opts.SASLMechanism); err != nil {
+			return nil, fmt.Errorf("failed to set sasl.mechanism: %w", err)
+		}
+	}
+
+	if opts.SASLUsername != "" {
+		if err := config.SetKey("sasl.username", opts.SASLUsername); err != nil {
+			return nil, fmt.Errorf("failed to set sasl.username: %w", err)
+		}
+	}
+
+	if opts.SASLPassword != "" {
+		if err := config.SetKey("sasl.password", opts.SASLPassword); err != nil {
+			return nil, fmt.Errorf("failed to set sasl.password: %w", err)
+		}
+	}
+
 	bootstrapVal, err := config.Get("bootstrap.servers", "")
 	if err != nil || bootstrapVal == "" {
 		return nil, fmt.Errorf("bootstrap.servers must be provided via --bootstrap-servers flag or in config file")
@@ -95,41 +153,206 @@ func buildKafkaConfig(bootstrapServers, configFile, securityProtocol string) (*k
 	return config, nil
 }
 
+// applyPEMFiles validates and sets the PEM/keystore-related ssl.* config keys. It collects
+// every missing or unreadable file before returning so readiness checks fail fast with a
+// complete picture instead of one error at a time.
+func applyPEMFiles(config *kafka.ConfigMap, opts KafkaConfigOptions) error {
+	pemFlags := []struct {
+		flag string
+		key  string
+		path string
+	}{
+		{"--ssl-ca-location", "ssl.ca.location", opts.SSLCALocation},
+		{"--ssl-certificate-location", "ssl.certificate.location", opts.SSLCertificateLocation},
+		{"--ssl-key-location", "ssl.key.location", opts.SSLKeyLocation},
+	}
+
+	var badFiles []string
+	for _, pf := range pemFlags {
+		if pf.path == "" {
+			continue
+		}
+		if err := validateReadableFile(pf.path); err != nil {
+			badFiles = append(badFiles, fmt.Sprintf("%s=%q (%s): %v", pf.flag, pf.path, pf.key, err))
+			continue
+		}
+		if err := config.SetKey(pf.key, pf.path); err != nil {
+			return fmt.Errorf("failed to set %s: %w", pf.key, err)
+		}
+	}
+
+	if len(badFiles) > 0 {
+		return fmt.Errorf("missing or unreadable SSL file(s): %s", strings.Join(badFiles, "; "))
+	}
+
+	return nil
+}
+
+// brokerCounter is the subset of *kafka.AdminClient used by waitForKafkaReady.
+// It exists so tests can exercise the retry logic against a stub instead of a
+// live broker.
+type brokerCounter interface {
+	GetMetadata(topic *string, allTopics bool, timeoutMs int) (*kafka.Metadata, error)
+}
+
+// clusterDescriber is the subset of *kafka.AdminClient used by
+// waitForControllerQuorumReady. It exists so tests can exercise the quorum
+// readiness logic against a stub instead of a live broker.
+type clusterDescriber interface {
+	DescribeCluster(ctx context.Context, options ...kafka.DescribeClusterAdminOption) (kafka.DescribeClusterResult, error)
+}
+
 // waitForKafkaReady polls the Kafka cluster until the minimum number of brokers are available
 // or the timeout expires. Returns nil on success, error on failure.
-func waitForKafkaReady(config *kafka.ConfigMap, minBrokers int, timeoutSecs int) error {
-	adminClient, err := kafka.NewAdminClient(config)
-	if err != nil {
-		return fmt.Errorf("failed to create admin client: %w", err)
+func waitForKafkaReady(client brokerCounter, minBrokers int, timeoutSecs int) error {
+	var brokerCount int
+	poll := readiness.PollerFunc(func() error {
+		metadata, err := client.GetMetadata(nil, true, defaultMetadataTimeoutMs)
+		if err != nil {
+			return fmt.Errorf("error getting metadata: %w", err)
+		}
+		brokerCount = len(metadata.Brokers)
+		if brokerCount < minBrokers {
+			return fmt.Errorf("expected %d brokers but found only %d", minBrokers, brokerCount)
+		}
+		return nil
+	})
+
+	backoff := time.Duration(brokerMetadataRequestBackoffMs) * time.Millisecond
+	timeout := time.Duration(timeoutSecs) * time.Second
+	if err := readiness.Wait(poll, backoff, timeout); err != nil {
+		return fmt.Errorf("timeout waiting for kafka: expected %d brokers but found %d", minBrokers, brokerCount)
 	}
-	defer adminClient.Close()
 
-	timeoutMs := timeoutSecs * 1000
-	startTime := time.Now()
-	var brokerCount int
+	fmt.Fprintf(os.Stderr, "Kafka is ready: found %d brokers (expected %d)\n", brokerCount, minBrokers)
+	return nil
+}
+
+// errQuorumLagUnavailable is returned when --max-quorum-lag is set to a
+// positive value: the vendored confluent-kafka-go/v2 v2.13.0 AdminClient has
+// no DescribeMetadataQuorum equivalent (KIP-595/KIP-700), so the log-end-offset
+// lag across controller voters can't be measured.
+var errQuorumLagUnavailable = fmt.Errorf("--max-quorum-lag requires AdminClient.DescribeMetadataQuorum, which confluent-kafka-go/v2 v2.13.0 does not expose yet; pass --max-quorum-lag=0 to skip the check")
+
+// isUnsupportedAdminAPI reports whether err indicates the broker doesn't
+// implement the admin API just called - the signal used to detect a
+// ZK-based cluster when DescribeCluster (KIP-700) isn't available.
+func isUnsupportedAdminAPI(err error) bool {
+	var kafkaErr kafka.Error
+	if errors.As(err, &kafkaErr) {
+		return kafkaErr.Code() == kafka.ErrUnsupportedVersion || kafkaErr.Code() == kafka.ErrUnsupportedFeature
+	}
+	return false
+}
 
-	for {
-		elapsed := time.Since(startTime)
-		remainingMs := timeoutMs - int(elapsed.Milliseconds())
+// waitForControllerQuorumReady polls the cluster's controller quorum until a
+// controller is elected and at least minControllers voters are reachable, or
+// the timeout expires. If the broker doesn't support DescribeCluster (a
+// ZK-based cluster predating KIP-500), it falls back to the plain
+// broker-count semantics of waitForKafkaReady.
+func waitForControllerQuorumReady(client interface {
+	clusterDescriber
+	brokerCounter
+}, minControllers int, maxQuorumLag int64, minBrokers int, timeoutSecs int) error {
+	if maxQuorumLag > 0 {
+		return errQuorumLagUnavailable
+	}
 
-		if remainingMs <= 0 {
-			return fmt.Errorf("timeout waiting for kafka: expected %d brokers but found %d", minBrokers, brokerCount)
+	probeCtx, cancel := context.WithTimeout(context.Background(), defaultMetadataTimeoutMs*time.Millisecond)
+	_, err := client.DescribeCluster(probeCtx)
+	cancel()
+	if err != nil {
+		if !isUnsupportedAdminAPI(err) {
+			return fmt.Errorf("error describing cluster: %w", err)
 		}
+		fmt.Fprintln(os.Stderr, "DescribeCluster is not supported by this broker (ZK-based cluster); falling back to broker-count readiness")
+		return waitForKafkaReady(client, minBrokers, timeoutSecs)
+	}
+
+	var voterCount int
+	var controllerElected bool
+	poll := readiness.PollerFunc(func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), defaultMetadataTimeoutMs*time.Millisecond)
+		defer cancel()
 
-		metadataTimeout := min(defaultMetadataTimeoutMs, remainingMs)
-		metadata, err := adminClient.GetMetadata(nil, true, metadataTimeout)
+		result, err := client.DescribeCluster(ctx)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error getting metadata: %v. Retrying...\n", err)
-		} else {
-			brokerCount = len(metadata.Brokers)
-			if brokerCount >= minBrokers {
-				fmt.Fprintf(os.Stderr, "Kafka is ready: found %d brokers (expected %d)\n", brokerCount, minBrokers)
-				return nil
-			}
-			fmt.Fprintf(os.Stderr, "Expected %d brokers but found only %d. Retrying...\n", minBrokers, brokerCount)
+			return fmt.Errorf("error describing cluster: %w", err)
+		}
+
+		voterCount = len(result.Nodes)
+		controllerElected = result.Controller != nil
+		if !controllerElected {
+			return fmt.Errorf("no controller elected yet")
+		}
+		if voterCount < minControllers {
+			return fmt.Errorf("expected %d controller-quorum voters but found only %d", minControllers, voterCount)
 		}
+		return nil
+	})
+
+	backoff := time.Duration(brokerMetadataRequestBackoffMs) * time.Millisecond
+	timeout := time.Duration(timeoutSecs) * time.Second
+	if err := readiness.Wait(poll, backoff, timeout); err != nil {
+		return fmt.Errorf("timeout waiting for controller quorum: expected %d voters, found %d (controller elected: %t)", minControllers, voterCount, controllerElected)
+	}
+
+	fmt.Fprintf(os.Stderr, "Controller quorum is ready: %d voters reachable (expected %d)\n", voterCount, minControllers)
+	return nil
+}
+
+// CheckKafkaReadyOptions holds the parsed flags for the check-kafka-ready command.
+type CheckKafkaReadyOptions struct {
+	MinBrokers string
+	Timeout    string
+	KafkaConfigOptions
+	SSH SSHTunnelOptions
+
+	ControllerQuorum bool
+	MinControllers   string
+	MaxQuorumLag     string
+}
+
+// checkKafkaReady validates the command's flags, builds a kafka.ConfigMap, and blocks
+// until the cluster reports the expected number of brokers or the timeout expires.
+// If opts.ControllerQuorum is set, it instead waits for a KRaft controller
+// quorum; see waitForControllerQuorumReady.
+func checkKafkaReady(opts CheckKafkaReadyOptions) error {
+	minBrokers, err := strconv.Atoi(opts.MinBrokers)
+	if err != nil {
+		return fmt.Errorf("invalid min-num-brokers %q: %w", opts.MinBrokers, err)
+	}
+
+	timeoutSecs, err := strconv.Atoi(opts.Timeout)
+	if err != nil {
+		return fmt.Errorf("invalid timeout %q: %w", opts.Timeout, err)
+	}
 
-		sleepDuration := min(brokerMetadataRequestBackoffMs, remainingMs)
-		time.Sleep(time.Duration(sleepDuration) * time.Millisecond)
+	config, closeTunnel, err := resolveKafkaConfig(opts.KafkaConfigOptions, opts.SSH)
+	if err != nil {
+		return err
 	}
+	defer closeTunnel()
+
+	adminClient, err := kafka.NewAdminClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create admin client: %w", err)
+	}
+	defer adminClient.Close()
+
+	if !opts.ControllerQuorum {
+		return waitForKafkaReady(adminClient, minBrokers, timeoutSecs)
+	}
+
+	minControllers, err := strconv.Atoi(opts.MinControllers)
+	if err != nil {
+		return fmt.Errorf("invalid min-controllers %q: %w", opts.MinControllers, err)
+	}
+
+	maxQuorumLag, err := strconv.ParseInt(opts.MaxQuorumLag, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid max-quorum-lag %q: %w", opts.MaxQuorumLag, err)
+	}
+
+	return waitForControllerQuorumReady(adminClient, minControllers, maxQuorumLag, minBrokers, timeoutSecs)
 }
@@ -1,9 +1,12 @@
 package main
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 )
 
 func TestParsePropertiesFile(t *testing.T) {
@@ -178,7 +181,11 @@ func TestBuildKafkaConfig(t *testing.T) {
 				}
 			}
 
-			got, err := buildKafkaConfig(tt.bootstrapServers, configFilePath, tt.securityProtocol)
+			got, err := buildKafkaConfig(KafkaConfigOptions{
+				BootstrapServers: tt.bootstrapServers,
+				ConfigFile:       configFilePath,
+				SecurityProtocol: tt.securityProtocol,
+			})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("buildKafkaConfig() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -204,12 +211,122 @@ func TestBuildKafkaConfig(t *testing.T) {
 }
 
 func TestBuildKafkaConfig_InvalidConfigFile(t *testing.T) {
-	_, err := buildKafkaConfig("", "/nonexistent/config.properties", "")
+	_, err := buildKafkaConfig(KafkaConfigOptions{ConfigFile: "/nonexistent/config.properties"})
 	if err == nil {
 		t.Error("buildKafkaConfig() expected error for nonexistent config file, got nil")
 	}
 }
 
+func TestBuildKafkaConfig_Auth(t *testing.T) {
+	tmpDir := t.TempDir()
+	caFile := filepath.Join(tmpDir, "ca.pem")
+	certFile := filepath.Join(tmpDir, "cert.pem")
+	keyFile := filepath.Join(tmpDir, "key.pem")
+
+	for _, f := range []string{caFile, certFile, keyFile} {
+		if err := os.WriteFile(f, []byte("dummy pem contents"), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", f, err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		opts    KafkaConfigOptions
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "SASL_PLAINTEXT with PLAIN",
+			opts: KafkaConfigOptions{
+				BootstrapServers: "localhost:9092",
+				SecurityProtocol: "SASL_PLAINTEXT",
+				SASLMechanism:    "PLAIN",
+				SASLUsername:     "alice",
+				SASLPassword:     "secret",
+			},
+			want: map[string]string{
+				"security.protocol": "SASL_PLAINTEXT",
+				"sasl.mechanism":    "PLAIN",
+				"sasl.username":     "alice",
+				"sasl.password":     "secret",
+			},
+		},
+		{
+			name: "SASL_SSL with SCRAM-SHA-512",
+			opts: KafkaConfigOptions{
+				BootstrapServers: "localhost:9092",
+				SecurityProtocol: "SASL_SSL",
+				SSLCALocation:    caFile,
+				SASLMechanism:    "SCRAM-SHA-512",
+				SASLUsername:     "alice",
+				SASLPassword:     "secret",
+			},
+			want: map[string]string{
+				"security.protocol": "SASL_SSL",
+				"ssl.ca.location":   caFile,
+				"sasl.mechanism":    "SCRAM-SHA-512",
+			},
+		},
+		{
+			name: "SSL with mTLS",
+			opts: KafkaConfigOptions{
+				BootstrapServers:       "localhost:9092",
+				SecurityProtocol:       "SSL",
+				SSLCALocation:          caFile,
+				SSLCertificateLocation: certFile,
+				SSLKeyLocation:         keyFile,
+				SSLKeyPassword:         "keypass",
+			},
+			want: map[string]string{
+				"security.protocol":        "SSL",
+				"ssl.ca.location":          caFile,
+				"ssl.certificate.location": certFile,
+				"ssl.key.location":         keyFile,
+				"ssl.key.password":         "keypass",
+			},
+		},
+		{
+			name: "missing CA file",
+			opts: KafkaConfigOptions{
+				BootstrapServers: "localhost:9092",
+				SecurityProtocol: "SSL",
+				SSLCALocation:    filepath.Join(tmpDir, "does-not-exist.pem"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing certificate and key files",
+			opts: KafkaConfigOptions{
+				BootstrapServers:       "localhost:9092",
+				SecurityProtocol:       "SSL",
+				SSLCertificateLocation: filepath.Join(tmpDir, "missing-cert.pem"),
+				SSLKeyLocation:         filepath.Join(tmpDir, "missing-key.pem"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildKafkaConfig(tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("buildKafkaConfig() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			for key, want := range tt.want {
+				value, _ := got.Get(key, "")
+				if value != want {
+					t.Errorf("buildKafkaConfig() %s = %v, want %v", key, value, want)
+				}
+			}
+		})
+	}
+}
+
 func TestCheckKafkaReady_InvalidArgs(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -244,7 +361,15 @@ func TestCheckKafkaReady_InvalidArgs(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := checkKafkaReady(tt.minBrokers, tt.timeout, tt.bootstrap, tt.configFile, tt.security)
+			err := checkKafkaReady(CheckKafkaReadyOptions{
+				MinBrokers: tt.minBrokers,
+				Timeout:    tt.timeout,
+				KafkaConfigOptions: KafkaConfigOptions{
+					BootstrapServers: tt.bootstrap,
+					ConfigFile:       tt.configFile,
+					SecurityProtocol: tt.security,
+				},
+			})
 			if err == nil {
 				t.Error("checkKafkaReady() expected error, got nil")
 				return
@@ -256,6 +381,127 @@ func TestCheckKafkaReady_InvalidArgs(t *testing.T) {
 	}
 }
 
+// stubAdminClient is a minimal stand-in for *kafka.AdminClient satisfying
+// brokerCounter and clusterDescriber, so waitForKafkaReady and
+// waitForControllerQuorumReady can be exercised without a live broker.
+type stubAdminClient struct {
+	metadata    *kafka.Metadata
+	metadataErr error
+
+	describeCluster    kafka.DescribeClusterResult
+	describeClusterErr error
+}
+
+func (s *stubAdminClient) GetMetadata(topic *string, allTopics bool, timeoutMs int) (*kafka.Metadata, error) {
+	return s.metadata, s.metadataErr
+}
+
+func (s *stubAdminClient) DescribeCluster(ctx context.Context, options ...kafka.DescribeClusterAdminOption) (kafka.DescribeClusterResult, error) {
+	return s.describeCluster, s.describeClusterErr
+}
+
+func TestWaitForKafkaReady_Stub(t *testing.T) {
+	tests := []struct {
+		name       string
+		client     *stubAdminClient
+		minBrokers int
+		wantErr    bool
+	}{
+		{
+			name:       "enough brokers",
+			client:     &stubAdminClient{metadata: &kafka.Metadata{Brokers: []kafka.BrokerMetadata{{ID: 1}, {ID: 2}, {ID: 3}}}},
+			minBrokers: 3,
+		},
+		{
+			name:       "not enough brokers",
+			client:     &stubAdminClient{metadata: &kafka.Metadata{Brokers: []kafka.BrokerMetadata{{ID: 1}}}},
+			minBrokers: 3,
+			wantErr:    true,
+		},
+		{
+			name:       "metadata error",
+			client:     &stubAdminClient{metadataErr: kafka.NewError(kafka.ErrTransport, "connection refused", false)},
+			minBrokers: 1,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := waitForKafkaReady(tt.client, tt.minBrokers, 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("waitForKafkaReady() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWaitForControllerQuorumReady_Stub(t *testing.T) {
+	node := kafka.Node{ID: 1, Host: "broker1", Port: 9092}
+
+	tests := []struct {
+		name           string
+		client         *stubAdminClient
+		minControllers int
+		maxQuorumLag   int64
+		wantErr        bool
+	}{
+		{
+			name: "controller elected with enough voters",
+			client: &stubAdminClient{describeCluster: kafka.DescribeClusterResult{
+				Controller: &node,
+				Nodes:      []kafka.Node{node, node, node},
+			}},
+			minControllers: 3,
+		},
+		{
+			name: "no controller elected",
+			client: &stubAdminClient{describeCluster: kafka.DescribeClusterResult{
+				Controller: nil,
+				Nodes:      []kafka.Node{node},
+			}},
+			minControllers: 1,
+			wantErr:        true,
+		},
+		{
+			name: "not enough voters",
+			client: &stubAdminClient{describeCluster: kafka.DescribeClusterResult{
+				Controller: &node,
+				Nodes:      []kafka.Node{node},
+			}},
+			minControllers: 3,
+			wantErr:        true,
+		},
+		{
+			name: "falls back to broker count on ZK cluster",
+			client: &stubAdminClient{
+				describeClusterErr: kafka.NewError(kafka.ErrUnsupportedVersion, "DescribeCluster not supported", false),
+				metadata:           &kafka.Metadata{Brokers: []kafka.BrokerMetadata{{ID: 1}, {ID: 2}}},
+			},
+			minControllers: 1,
+		},
+		{
+			name: "max quorum lag not supported",
+			client: &stubAdminClient{describeCluster: kafka.DescribeClusterResult{
+				Controller: &node,
+				Nodes:      []kafka.Node{node},
+			}},
+			minControllers: 1,
+			maxQuorumLag:   100,
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := waitForControllerQuorumReady(tt.client, tt.minControllers, tt.maxQuorumLag, 1, 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("waitForControllerQuorumReady() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(substr) == 0 ||
 		(len(s) > 0 && len(substr) > 0 && findSubstring(s, substr)))
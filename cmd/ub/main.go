@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func main() {
+	if err := newRootCmd().Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func newRootCmd() *cobra.Command {
+	root := &cobra.Command{
+		Use:   "ub",
+		Short: "ub is a collection of Kafka utility commands used by cp-docker-utils",
+	}
+
+	root.AddCommand(newCheckKafkaReadyCmd())
+	root.AddCommand(newCheckSchemaRegistryReadyCmd())
+	root.AddCommand(newTopicsCmd())
+	root.AddCommand(newACLsCmd())
+	root.AddCommand(newReassignPartitionsCmd())
+
+	return root
+}
+
+func newCheckKafkaReadyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-kafka-ready",
+		Short: "Wait until a Kafka cluster reports the expected number of brokers",
+	}
+
+	connOpts := addConnectionFlags(cmd.Flags())
+
+	var minBrokers, timeout string
+	var controllerQuorum bool
+	var minControllers, maxQuorumLag string
+	cmd.Flags().StringVar(&minBrokers, "min-num-brokers", "1", "minimum number of brokers that must be available")
+	cmd.Flags().StringVar(&timeout, "timeout", "10", "number of seconds to wait for the cluster to become ready")
+	cmd.Flags().BoolVar(&controllerQuorum, "controller-quorum", false, "wait for a KRaft controller quorum instead of a broker count (falls back to broker count on ZK-based clusters)")
+	cmd.Flags().StringVar(&minControllers, "min-controllers", "1", "minimum number of controller-quorum voters that must be reachable (with --controller-quorum)")
+	cmd.Flags().StringVar(&maxQuorumLag, "max-quorum-lag", "0", "maximum allowed log-end-offset lag across controller-quorum voters; must be 0 until confluent-kafka-go exposes DescribeMetadataQuorum (with --controller-quorum)")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return checkKafkaReady(CheckKafkaReadyOptions{
+			MinBrokers:         minBrokers,
+			Timeout:            timeout,
+			KafkaConfigOptions: connOpts.Kafka,
+			SSH:                connOpts.SSH,
+			ControllerQuorum:   controllerQuorum,
+			MinControllers:     minControllers,
+			MaxQuorumLag:       maxQuorumLag,
+		})
+	}
+
+	return cmd
+}
+
+func newCheckSchemaRegistryReadyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-schema-registry-ready",
+		Short: "Wait until a Schema Registry responds successfully on every --url",
+	}
+
+	var opts CheckSchemaRegistryReadyOptions
+	cmd.Flags().StringArrayVar(&opts.URLs, "url", nil, "Schema Registry base URL to check, repeatable (required)")
+	cmd.Flags().StringVar(&opts.User, "user", "", "username for HTTP basic auth")
+	cmd.Flags().StringVar(&opts.Password, "password", "", "password for HTTP basic auth (falls back to SCHEMA_REGISTRY_BASIC_AUTH_USER_INFO=user:password)")
+	cmd.Flags().StringVar(&opts.TLSCALocation, "tls-ca", "", "path to the CA certificate file used to verify the registry's certificate")
+	cmd.Flags().StringVar(&opts.TLSCertificateLocation, "tls-cert", "", "path to the client's public key (PEM) used for mTLS")
+	cmd.Flags().StringVar(&opts.TLSKeyLocation, "tls-key", "", "path to the client's private key (PEM) used for mTLS")
+	cmd.Flags().StringVar(&opts.Timeout, "timeout", "10", "number of seconds to wait for the registry to become ready")
+	cmd.MarkFlagRequired("url")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return checkSchemaRegistryReady(opts)
+	}
+
+	return cmd
+}
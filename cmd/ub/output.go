@@ -0,0 +1,56 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+// outputFormat identifies how command results should be rendered.
+type outputFormat string
+
+const (
+	outputFormatTable outputFormat = "table"
+	outputFormatJSON  outputFormat = "json"
+)
+
+// parseOutputFormat validates the --output flag value.
+func parseOutputFormat(value string) (outputFormat, error) {
+	switch outputFormat(value) {
+	case outputFormatTable, outputFormatJSON:
+		return outputFormat(value), nil
+	default:
+		return "", fmt.Errorf("invalid --output %q: must be %q or %q", value, outputFormatTable, outputFormatJSON)
+	}
+}
+
+// writeResult renders data as either a tab-aligned table or indented JSON.
+// headers and rows are used for the table form; data is marshaled as-is for
+// the JSON form.
+func writeResult(w io.Writer, format outputFormat, headers []string, rows [][]string, data interface{}) error {
+	switch format {
+	case outputFormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(data)
+	default:
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, tabRow(headers))
+		for _, row := range rows {
+			fmt.Fprintln(tw, tabRow(row))
+		}
+		return tw.Flush()
+	}
+}
+
+func tabRow(cols []string) string {
+	line := ""
+	for i, c := range cols {
+		if i > 0 {
+			line += "\t"
+		}
+		line += c
+	}
+	return line
+}
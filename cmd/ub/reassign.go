@@ -0,0 +1,66 @@
+package main
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+)
+
+// errReassignmentAPIUnavailable is returned by every reassign-partitions
+// subcommand. confluent-kafka-go/v2 v2.13.0's AdminClient does not expose
+// AlterPartitionReassignments or ListPartitionReassignments (KIP-455), so
+// there is no API this command could call; it fails fast here instead of
+// shipping silently as a no-op.
+var errReassignmentAPIUnavailable = errors.New("reassign-partitions is not yet implemented: confluent-kafka-go/v2 v2.13.0 does not expose the KIP-455 AdminClient APIs (AlterPartitionReassignments/ListPartitionReassignments) this command needs")
+
+// newReassignPartitionsCmd returns the reassign-partitions command tree. It
+// exists to document, via --help, that alter/list/cancel were requested but
+// are blocked on an upstream confluent-kafka-go/v2 dependency gap; every
+// subcommand returns errReassignmentAPIUnavailable rather than doing nothing.
+func newReassignPartitionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reassign-partitions",
+		Short: "Alter, list, and cancel Kafka partition reassignments (blocked, see --help)",
+		Long: "reassign-partitions is currently a stub. confluent-kafka-go/v2 v2.13.0's " +
+			"AdminClient does not expose the KIP-455 AlterPartitionReassignments or " +
+			"ListPartitionReassignments APIs, so alter/list/cancel cannot be implemented " +
+			"against this version. Every subcommand fails immediately with an explanation " +
+			"instead of silently doing nothing.",
+	}
+
+	cmd.AddCommand(newReassignAlterCmd())
+	cmd.AddCommand(newReassignListCmd())
+	cmd.AddCommand(newReassignCancelCmd())
+
+	return cmd
+}
+
+func newReassignAlterCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "alter",
+		Short: "Submit a partition reassignment plan (blocked, see `ub reassign-partitions --help`)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return errReassignmentAPIUnavailable
+		},
+	}
+}
+
+func newReassignListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List in-progress partition reassignments (blocked, see `ub reassign-partitions --help`)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return errReassignmentAPIUnavailable
+		},
+	}
+}
+
+func newReassignCancelCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel",
+		Short: "Cancel an in-flight partition reassignment (blocked, see `ub reassign-partitions --help`)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return errReassignmentAPIUnavailable
+		},
+	}
+}
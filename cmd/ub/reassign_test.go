@@ -0,0 +1,26 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestReassignPartitionsSubcommands_FailFast(t *testing.T) {
+	subcommands := map[string]func() *cobra.Command{
+		"alter":  newReassignAlterCmd,
+		"list":   newReassignListCmd,
+		"cancel": newReassignCancelCmd,
+	}
+
+	for name, newCmd := range subcommands {
+		t.Run(name, func(t *testing.T) {
+			cmd := newCmd()
+			err := cmd.RunE(cmd, nil)
+			if !errors.Is(err, errReassignmentAPIUnavailable) {
+				t.Errorf("RunE() error = %v, want errReassignmentAPIUnavailable", err)
+			}
+		})
+	}
+}
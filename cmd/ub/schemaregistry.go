@@ -0,0 +1,178 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/confluentinc/cp-docker-utils/internal/readiness"
+)
+
+const (
+	schemaRegistryPollBackoffMs  = 1000
+	schemaRegistryRequestTimeout = 5 * time.Second
+	schemaRegistryReadinessPath  = "/subjects"
+
+	// schemaRegistryBasicAuthEnvVar mirrors the env var Schema Registry's own
+	// CLI tools read when --user/--password aren't given.
+	schemaRegistryBasicAuthEnvVar = "SCHEMA_REGISTRY_BASIC_AUTH_USER_INFO"
+)
+
+// SchemaRegistryOptions holds the parameters used to reach one or more
+// Schema Registry instances.
+type SchemaRegistryOptions struct {
+	URLs     []string
+	User     string
+	Password string
+
+	TLSCALocation          string
+	TLSCertificateLocation string
+	TLSKeyLocation         string
+}
+
+// CheckSchemaRegistryReadyOptions holds the parsed flags for the
+// check-schema-registry-ready command.
+type CheckSchemaRegistryReadyOptions struct {
+	Timeout string
+	SchemaRegistryOptions
+}
+
+// resolveSchemaRegistryBasicAuth returns the basic auth credentials to use,
+// preferring --user/--password and falling back to
+// SCHEMA_REGISTRY_BASIC_AUTH_USER_INFO (in "user:password" form).
+func resolveSchemaRegistryBasicAuth(opts SchemaRegistryOptions) (string, string, error) {
+	if opts.User != "" {
+		return opts.User, opts.Password, nil
+	}
+
+	userInfo := os.Getenv(schemaRegistryBasicAuthEnvVar)
+	if userInfo == "" {
+		return "", "", nil
+	}
+
+	user, password, ok := strings.Cut(userInfo, ":")
+	if !ok {
+		return "", "", fmt.Errorf("%s must be in the form user:password", schemaRegistryBasicAuthEnvVar)
+	}
+	return user, password, nil
+}
+
+// buildSchemaRegistryTLSConfig builds a *tls.Config for mTLS against the
+// registry, or nil if none of the --tls-* flags were given.
+func buildSchemaRegistryTLSConfig(opts SchemaRegistryOptions) (*tls.Config, error) {
+	if opts.TLSCALocation == "" && opts.TLSCertificateLocation == "" && opts.TLSKeyLocation == "" {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{}
+
+	if opts.TLSCALocation != "" {
+		caCert, err := os.ReadFile(opts.TLSCALocation)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read --tls-ca %q: %w", opts.TLSCALocation, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("--tls-ca %q contains no valid certificates", opts.TLSCALocation)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if opts.TLSCertificateLocation != "" || opts.TLSKeyLocation != "" {
+		if opts.TLSCertificateLocation == "" || opts.TLSKeyLocation == "" {
+			return nil, fmt.Errorf("--tls-cert and --tls-key must be provided together")
+		}
+		cert, err := tls.LoadX509KeyPair(opts.TLSCertificateLocation, opts.TLSKeyLocation)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate from --tls-cert/--tls-key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// buildSchemaRegistryHTTPClient builds the http.Client used to poll the
+// registry, configuring mTLS if any --tls-* flags were given.
+func buildSchemaRegistryHTTPClient(opts SchemaRegistryOptions) (*http.Client, error) {
+	tlsConfig, err := buildSchemaRegistryTLSConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig == nil {
+		return &http.Client{Timeout: schemaRegistryRequestTimeout}, nil
+	}
+	return &http.Client{
+		Timeout:   schemaRegistryRequestTimeout,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+	}, nil
+}
+
+// checkSchemaRegistryURLReady considers url ready if GET url/subjects
+// returns a 2xx response.
+func checkSchemaRegistryURLReady(client *http.Client, url, user, password string) error {
+	req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(url, "/")+schemaRegistryReadinessPath, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	if user != "" {
+		req.SetBasicAuth(user, password)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// checkSchemaRegistryReady validates the command's flags and blocks until
+// every --url responds successfully or the timeout expires.
+func checkSchemaRegistryReady(opts CheckSchemaRegistryReadyOptions) error {
+	if len(opts.URLs) == 0 {
+		return fmt.Errorf("at least one --url is required")
+	}
+
+	timeoutSecs, err := strconv.Atoi(opts.Timeout)
+	if err != nil {
+		return fmt.Errorf("invalid timeout %q: %w", opts.Timeout, err)
+	}
+
+	user, password, err := resolveSchemaRegistryBasicAuth(opts.SchemaRegistryOptions)
+	if err != nil {
+		return err
+	}
+
+	client, err := buildSchemaRegistryHTTPClient(opts.SchemaRegistryOptions)
+	if err != nil {
+		return err
+	}
+
+	poll := readiness.PollerFunc(func() error {
+		for _, url := range opts.URLs {
+			if err := checkSchemaRegistryURLReady(client, url, user, password); err != nil {
+				return fmt.Errorf("%s not ready: %w", url, err)
+			}
+		}
+		return nil
+	})
+
+	backoff := time.Duration(schemaRegistryPollBackoffMs) * time.Millisecond
+	timeout := time.Duration(timeoutSecs) * time.Second
+	if err := readiness.Wait(poll, backoff, timeout); err != nil {
+		return fmt.Errorf("timeout waiting for schema registry: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Schema Registry is ready: %s\n", strings.Join(opts.URLs, ", "))
+	return nil
+}
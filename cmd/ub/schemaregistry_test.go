@@ -0,0 +1,155 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResolveSchemaRegistryBasicAuth(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         SchemaRegistryOptions
+		envValue     string
+		wantUser     string
+		wantPassword string
+		wantErr      bool
+	}{
+		{
+			name:         "flags take precedence",
+			opts:         SchemaRegistryOptions{User: "alice", Password: "secret"},
+			envValue:     "bob:other",
+			wantUser:     "alice",
+			wantPassword: "secret",
+		},
+		{
+			name:         "falls back to env var",
+			envValue:     "bob:other",
+			wantUser:     "bob",
+			wantPassword: "other",
+		},
+		{
+			name: "neither set",
+		},
+		{
+			name:     "malformed env var",
+			envValue: "no-colon",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(schemaRegistryBasicAuthEnvVar, tt.envValue)
+
+			user, password, err := resolveSchemaRegistryBasicAuth(tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveSchemaRegistryBasicAuth() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if user != tt.wantUser || password != tt.wantPassword {
+				t.Errorf("resolveSchemaRegistryBasicAuth() = (%q, %q), want (%q, %q)", user, password, tt.wantUser, tt.wantPassword)
+			}
+		})
+	}
+}
+
+func TestCheckSchemaRegistryURLReady(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		wantErr    bool
+	}{
+		{name: "200 is ready", statusCode: http.StatusOK},
+		{name: "204 is ready", statusCode: http.StatusNoContent},
+		{name: "500 is not ready", statusCode: http.StatusInternalServerError, wantErr: true},
+		{name: "404 is not ready", statusCode: http.StatusNotFound, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != schemaRegistryReadinessPath {
+					t.Errorf("request path = %q, want %q", r.URL.Path, schemaRegistryReadinessPath)
+				}
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			err := checkSchemaRegistryURLReady(server.Client(), server.URL, "", "")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkSchemaRegistryURLReady() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckSchemaRegistryURLReady_BasicAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		if !ok || user != "alice" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	if err := checkSchemaRegistryURLReady(server.Client(), server.URL, "alice", "secret"); err != nil {
+		t.Errorf("checkSchemaRegistryURLReady() error = %v, want nil", err)
+	}
+	if err := checkSchemaRegistryURLReady(server.Client(), server.URL, "alice", "wrong"); err == nil {
+		t.Error("checkSchemaRegistryURLReady() with wrong password expected error, got nil")
+	}
+}
+
+func TestCheckSchemaRegistryReady_InvalidArgs(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         CheckSchemaRegistryReadyOptions
+		wantErrMatch string
+	}{
+		{
+			name:         "missing url",
+			opts:         CheckSchemaRegistryReadyOptions{Timeout: "10"},
+			wantErrMatch: "at least one --url is required",
+		},
+		{
+			name: "invalid timeout",
+			opts: CheckSchemaRegistryReadyOptions{
+				Timeout:               "not-a-number",
+				SchemaRegistryOptions: SchemaRegistryOptions{URLs: []string{"http://localhost:8081"}},
+			},
+			wantErrMatch: "invalid timeout",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkSchemaRegistryReady(tt.opts)
+			if err == nil {
+				t.Fatal("checkSchemaRegistryReady() expected error, got nil")
+			}
+			if !contains(err.Error(), tt.wantErrMatch) {
+				t.Errorf("checkSchemaRegistryReady() error = %v, want match %q", err, tt.wantErrMatch)
+			}
+		})
+	}
+}
+
+func TestCheckSchemaRegistryReady_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	err := checkSchemaRegistryReady(CheckSchemaRegistryReadyOptions{
+		Timeout:               "5",
+		SchemaRegistryOptions: SchemaRegistryOptions{URLs: []string{server.URL}},
+	})
+	if err != nil {
+		t.Errorf("checkSchemaRegistryReady() error = %v, want nil", err)
+	}
+}
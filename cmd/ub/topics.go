@@ -0,0 +1,338 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/spf13/cobra"
+)
+
+// parseConfigEntries turns repeated "key=value" strings into ConfigEntry values.
+func parseConfigEntries(pairs []string) ([]kafka.ConfigEntry, error) {
+	entries := make([]kafka.ConfigEntry, 0, len(pairs))
+	for _, pair := range pairs {
+		idx := strings.Index(pair, "=")
+		if idx == -1 {
+			return nil, fmt.Errorf("invalid --config %q: expected key=value", pair)
+		}
+		entries = append(entries, kafka.ConfigEntry{
+			Name:  pair[:idx],
+			Value: pair[idx+1:],
+		})
+	}
+	return entries, nil
+}
+
+func newTopicsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "topics",
+		Short: "Create, list, describe, delete, and reconfigure Kafka topics",
+	}
+
+	cmd.AddCommand(newTopicsCreateCmd())
+	cmd.AddCommand(newTopicsListCmd())
+	cmd.AddCommand(newTopicsDescribeCmd())
+	cmd.AddCommand(newTopicsDeleteCmd())
+	cmd.AddCommand(newTopicsAlterConfigsCmd())
+
+	return cmd
+}
+
+func newTopicsCreateCmd() *cobra.Command {
+	var (
+		topic             string
+		partitions        int
+		replicationFactor int
+		configPairs       []string
+		timeoutSecs       int
+		output            string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a Kafka topic",
+	}
+	connOpts := addConnectionFlags(cmd.Flags())
+
+	cmd.Flags().StringVar(&topic, "topic", "", "name of the topic to create (required)")
+	cmd.Flags().IntVar(&partitions, "partitions", 1, "number of partitions")
+	cmd.Flags().IntVar(&replicationFactor, "replication-factor", 1, "replication factor")
+	cmd.Flags().StringArrayVar(&configPairs, "config", nil, "topic config in key=value form, repeatable")
+	cmd.Flags().IntVar(&timeoutSecs, "timeout", 30, "number of seconds to wait for the operation to complete")
+	cmd.Flags().StringVar(&output, "output", "table", "output format: json or table")
+	cmd.MarkFlagRequired("topic")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		format, err := parseOutputFormat(output)
+		if err != nil {
+			return err
+		}
+
+		config, err := parseConfigEntries(configPairs)
+		if err != nil {
+			return err
+		}
+
+		topicConfig := make(map[string]string, len(config))
+		for _, entry := range config {
+			topicConfig[entry.Name] = entry.Value
+		}
+
+		admin, closeAdmin, err := newConnectedAdminClient(connOpts.Kafka, connOpts.SSH)
+		if err != nil {
+			return fmt.Errorf("failed to create admin client: %w", err)
+		}
+		defer closeAdmin()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSecs)*time.Second)
+		defer cancel()
+
+		results, err := admin.CreateTopics(ctx, []kafka.TopicSpecification{{
+			Topic:             topic,
+			NumPartitions:     partitions,
+			ReplicationFactor: replicationFactor,
+			Config:            topicConfig,
+		}})
+		if err != nil {
+			return fmt.Errorf("failed to create topic %q: %w", topic, err)
+		}
+
+		return writeTopicResults(os.Stdout, format, results)
+	}
+
+	return cmd
+}
+
+func newTopicsListCmd() *cobra.Command {
+	var (
+		timeoutSecs int
+		output      string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List topics known to the cluster",
+	}
+	connOpts := addConnectionFlags(cmd.Flags())
+
+	cmd.Flags().IntVar(&timeoutSecs, "timeout", 30, "number of seconds to wait for metadata")
+	cmd.Flags().StringVar(&output, "output", "table", "output format: json or table")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		format, err := parseOutputFormat(output)
+		if err != nil {
+			return err
+		}
+
+		admin, closeAdmin, err := newConnectedAdminClient(connOpts.Kafka, connOpts.SSH)
+		if err != nil {
+			return fmt.Errorf("failed to create admin client: %w", err)
+		}
+		defer closeAdmin()
+
+		metadata, err := admin.GetMetadata(nil, true, timeoutSecs*1000)
+		if err != nil {
+			return fmt.Errorf("failed to list topics: %w", err)
+		}
+
+		type topicInfo struct {
+			Name       string `json:"name"`
+			Partitions int    `json:"partitions"`
+		}
+
+		var topics []topicInfo
+		for name, t := range metadata.Topics {
+			topics = append(topics, topicInfo{Name: name, Partitions: len(t.Partitions)})
+		}
+
+		rows := make([][]string, 0, len(topics))
+		for _, t := range topics {
+			rows = append(rows, []string{t.Name, strconv.Itoa(t.Partitions)})
+		}
+
+		return writeResult(os.Stdout, format, []string{"TOPIC", "PARTITIONS"}, rows, topics)
+	}
+
+	return cmd
+}
+
+func newTopicsDescribeCmd() *cobra.Command {
+	var (
+		topics      []string
+		timeoutSecs int
+		output      string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "describe",
+		Short: "Describe one or more topics",
+	}
+	connOpts := addConnectionFlags(cmd.Flags())
+
+	cmd.Flags().StringArrayVar(&topics, "topic", nil, "topic to describe, repeatable (required)")
+	cmd.Flags().IntVar(&timeoutSecs, "timeout", 30, "number of seconds to wait for the operation to complete")
+	cmd.Flags().StringVar(&output, "output", "table", "output format: json or table")
+	cmd.MarkFlagRequired("topic")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		format, err := parseOutputFormat(output)
+		if err != nil {
+			return err
+		}
+
+		admin, closeAdmin, err := newConnectedAdminClient(connOpts.Kafka, connOpts.SSH)
+		if err != nil {
+			return fmt.Errorf("failed to create admin client: %w", err)
+		}
+		defer closeAdmin()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSecs)*time.Second)
+		defer cancel()
+
+		result, err := admin.DescribeTopics(ctx, kafka.NewTopicCollectionOfTopicNames(topics))
+		if err != nil {
+			return fmt.Errorf("failed to describe topics: %w", err)
+		}
+
+		rows := make([][]string, 0, len(result.TopicDescriptions))
+		for _, td := range result.TopicDescriptions {
+			status := "OK"
+			if td.Error.Code() != kafka.ErrNoError {
+				status = td.Error.String()
+			}
+			rows = append(rows, []string{td.Name, strconv.Itoa(len(td.Partitions)), status})
+		}
+
+		return writeResult(os.Stdout, format, []string{"TOPIC", "PARTITIONS", "STATUS"}, rows, result.TopicDescriptions)
+	}
+
+	return cmd
+}
+
+func newTopicsDeleteCmd() *cobra.Command {
+	var (
+		topics      []string
+		timeoutSecs int
+		output      string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete one or more topics",
+	}
+	connOpts := addConnectionFlags(cmd.Flags())
+
+	cmd.Flags().StringArrayVar(&topics, "topic", nil, "topic to delete, repeatable (required)")
+	cmd.Flags().IntVar(&timeoutSecs, "timeout", 30, "number of seconds to wait for the operation to complete")
+	cmd.Flags().StringVar(&output, "output", "table", "output format: json or table")
+	cmd.MarkFlagRequired("topic")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		format, err := parseOutputFormat(output)
+		if err != nil {
+			return err
+		}
+
+		admin, closeAdmin, err := newConnectedAdminClient(connOpts.Kafka, connOpts.SSH)
+		if err != nil {
+			return fmt.Errorf("failed to create admin client: %w", err)
+		}
+		defer closeAdmin()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSecs)*time.Second)
+		defer cancel()
+
+		results, err := admin.DeleteTopics(ctx, topics)
+		if err != nil {
+			return fmt.Errorf("failed to delete topics: %w", err)
+		}
+
+		return writeTopicResults(os.Stdout, format, results)
+	}
+
+	return cmd
+}
+
+func newTopicsAlterConfigsCmd() *cobra.Command {
+	var (
+		topic       string
+		configPairs []string
+		timeoutSecs int
+		output      string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "alter-configs",
+		Short: "Alter a topic's configuration",
+	}
+	connOpts := addConnectionFlags(cmd.Flags())
+
+	cmd.Flags().StringVar(&topic, "topic", "", "name of the topic to alter (required)")
+	cmd.Flags().StringArrayVar(&configPairs, "config", nil, "topic config in key=value form, repeatable (required)")
+	cmd.Flags().IntVar(&timeoutSecs, "timeout", 30, "number of seconds to wait for the operation to complete")
+	cmd.Flags().StringVar(&output, "output", "table", "output format: json or table")
+	cmd.MarkFlagRequired("topic")
+	cmd.MarkFlagRequired("config")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		format, err := parseOutputFormat(output)
+		if err != nil {
+			return err
+		}
+
+		config, err := parseConfigEntries(configPairs)
+		if err != nil {
+			return err
+		}
+
+		admin, closeAdmin, err := newConnectedAdminClient(connOpts.Kafka, connOpts.SSH)
+		if err != nil {
+			return fmt.Errorf("failed to create admin client: %w", err)
+		}
+		defer closeAdmin()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSecs)*time.Second)
+		defer cancel()
+
+		results, err := admin.AlterConfigs(ctx, []kafka.ConfigResource{{
+			Type:   kafka.ResourceTopic,
+			Name:   topic,
+			Config: config,
+		}})
+		if err != nil {
+			return fmt.Errorf("failed to alter configs for topic %q: %w", topic, err)
+		}
+
+		rows := make([][]string, 0, len(results))
+		for _, r := range results {
+			status := "OK"
+			if r.Error.Code() != kafka.ErrNoError {
+				status = r.Error.String()
+			}
+			rows = append(rows, []string{r.Name, status})
+		}
+
+		return writeResult(os.Stdout, format, []string{"RESOURCE", "STATUS"}, rows, results)
+	}
+
+	return cmd
+}
+
+// writeTopicResults renders the common []kafka.TopicResult shape returned by
+// CreateTopics and DeleteTopics.
+func writeTopicResults(w *os.File, format outputFormat, results []kafka.TopicResult) error {
+	rows := make([][]string, 0, len(results))
+	for _, r := range results {
+		status := "OK"
+		if r.Error.Code() != kafka.ErrNoError {
+			status = r.Error.String()
+		}
+		rows = append(rows, []string{r.Topic, status})
+	}
+	return writeResult(w, format, []string{"TOPIC", "STATUS"}, rows, results)
+}
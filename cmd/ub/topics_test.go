@@ -0,0 +1,56 @@
+package main
+
+import "testing"
+
+func TestParseConfigEntries(t *testing.T) {
+	tests := []struct {
+		name    string
+		pairs   []string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "single pair",
+			pairs: []string{"retention.ms=604800000"},
+			want:  map[string]string{"retention.ms": "604800000"},
+		},
+		{
+			name:  "multiple pairs",
+			pairs: []string{"retention.ms=604800000", "cleanup.policy=compact"},
+			want:  map[string]string{"retention.ms": "604800000", "cleanup.policy": "compact"},
+		},
+		{
+			name:  "value containing equals sign",
+			pairs: []string{"confluent.value.schema.validation=true"},
+			want:  map[string]string{"confluent.value.schema.validation": "true"},
+		},
+		{
+			name:    "missing equals sign",
+			pairs:   []string{"retention.ms"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseConfigEntries(tt.pairs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseConfigEntries() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if len(got) != len(tt.want) {
+				t.Errorf("parseConfigEntries() got %d entries, want %d", len(got), len(tt.want))
+			}
+
+			for _, entry := range got {
+				if want, ok := tt.want[entry.Name]; !ok || want != entry.Value {
+					t.Errorf("parseConfigEntries() entry %q = %q, want %q", entry.Name, entry.Value, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Package readiness provides a shared retry loop for "wait until this
+// component is up" commands such as check-kafka-ready and
+// check-schema-registry-ready.
+package readiness
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// Poller performs a single readiness check attempt for a component (a Kafka
+// cluster, a Schema Registry, a KRaft controller quorum, ...). Poll returns
+// nil once the component is ready, or an error describing why it isn't yet.
+type Poller interface {
+	Poll() error
+}
+
+// PollerFunc adapts a plain function to the Poller interface.
+type PollerFunc func() error
+
+func (f PollerFunc) Poll() error { return f() }
+
+// Wait calls p.Poll on a constant backoff until it succeeds or timeout
+// elapses. A non-positive timeout returns a timeout error without calling
+// Poll at all. Each unsuccessful attempt is logged to stderr so long waits
+// show progress; the final error wraps the last attempt's error.
+func Wait(p Poller, backoff, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	if timeout <= 0 {
+		return fmt.Errorf("timed out waiting for readiness: timeout expired before the first attempt")
+	}
+
+	for {
+		err := p.Poll()
+		if err == nil {
+			return nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return fmt.Errorf("timed out waiting for readiness: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "%v. Retrying...\n", err)
+		time.Sleep(min(backoff, remaining))
+	}
+}
@@ -0,0 +1,51 @@
+package readiness
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWait_SucceedsAfterRetries(t *testing.T) {
+	attempts := 0
+	poll := PollerFunc(func() error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("not ready yet")
+		}
+		return nil
+	})
+
+	if err := Wait(poll, time.Millisecond, time.Second); err != nil {
+		t.Fatalf("Wait() error = %v, want nil", err)
+	}
+	if attempts != 3 {
+		t.Errorf("Wait() made %d attempts, want 3", attempts)
+	}
+}
+
+func TestWait_TimesOut(t *testing.T) {
+	poll := PollerFunc(func() error {
+		return errors.New("still not ready")
+	})
+
+	err := Wait(poll, time.Millisecond, 10*time.Millisecond)
+	if err == nil {
+		t.Fatal("Wait() expected error, got nil")
+	}
+}
+
+func TestWait_NonPositiveTimeoutNeverPolls(t *testing.T) {
+	polled := false
+	poll := PollerFunc(func() error {
+		polled = true
+		return nil
+	})
+
+	if err := Wait(poll, time.Millisecond, 0); err == nil {
+		t.Error("Wait() with a zero timeout expected an error, got nil")
+	}
+	if polled {
+		t.Error("Wait() with a zero timeout called Poll, want no attempt")
+	}
+}